@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/gorilla/mux"
+)
+
+// containerPathStatHeader carries a base64-encoded, JSON-marshalled
+// types.ContainerPathStat alongside archive responses, mirroring Docker's
+// X-Docker-Container-Path-Stat.
+const containerPathStatHeader = "X-Docker-Container-Path-Stat"
+
+// getContainerArchive handles GET /containers/{name:.*}/archive, streaming
+// path out of the container as a tar archive.
+func (s *Server) getContainerArchive(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	path := req.FormValue("path")
+
+	tarStream, stat, err := s.ContainerMgr.ArchivePath(ctx, name, path)
+	if err != nil {
+		return err
+	}
+	defer tarStream.Close()
+
+	if err := setPathStatHeader(rw, stat); err != nil {
+		return err
+	}
+	rw.Header().Set("Content-Type", "application/x-tar")
+	rw.WriteHeader(http.StatusOK)
+	_, err = io.Copy(rw, tarStream)
+	return err
+}
+
+// headContainerArchive handles HEAD /containers/{name:.*}/archive, reporting
+// path's stat without transferring its contents.
+func (s *Server) headContainerArchive(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	path := req.FormValue("path")
+
+	stat, err := s.ContainerMgr.StatPath(ctx, name, path)
+	if err != nil {
+		return err
+	}
+
+	if err := setPathStatHeader(rw, stat); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// putContainerArchive handles PUT /containers/{name:.*}/archive, extracting
+// the request body as a tar archive at path inside the container.
+func (s *Server) putContainerArchive(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	path := req.FormValue("path")
+	noOverwriteDirNonDir, _ := strconv.ParseBool(req.FormValue("noOverwriteDirNonDir"))
+
+	if err := s.ContainerMgr.ExtractToDir(ctx, name, path, req.Body, noOverwriteDirNonDir); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// setPathStatHeader sets the containerPathStatHeader on rw from stat.
+func setPathStatHeader(rw http.ResponseWriter, stat types.ContainerPathStat) error {
+	raw, err := json.Marshal(stat)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s header: %v", containerPathStatHeader, err)
+	}
+	rw.Header().Set(containerPathStatHeader, base64.StdEncoding.EncodeToString(raw))
+	return nil
+}
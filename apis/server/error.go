@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/errdefs"
+)
+
+// handlerFunc is implemented by each API handler. Returning a non-nil error
+// from one lets writeError pick the response status code from the error's
+// errdefs classification, instead of each handler choosing a status code by
+// hand.
+type handlerFunc func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error
+
+// wrap adapts a handlerFunc to http.HandlerFunc, routing any returned error
+// through writeError. This is the single place response status codes are
+// derived from an error's errdefs classification.
+func wrap(h handlerFunc) http.HandlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request) {
+		if err := h(req.Context(), rw, req); err != nil {
+			writeError(rw, err)
+		}
+	}
+}
+
+// writeError writes err as a JSON types.Error body with a status code
+// derived from errdefs.GetHTTPErrorStatusCode.
+func writeError(rw http.ResponseWriter, err error) {
+	status := errdefs.GetHTTPErrorStatusCode(err)
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(types.Error{Message: err.Error()})
+}
+
+// EncodeResponse writes v as the JSON-encoded response body with the given
+// status code.
+func EncodeResponse(rw http.ResponseWriter, status int, v interface{}) error {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	return json.NewEncoder(rw).Encode(v)
+}
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// removeImage handles DELETE /images/{name:.*}, untagging or deleting the
+// referenced image and rendering the result as one JSON item per
+// "Untagged"/"Deleted" line, mirroring Docker's rmi response.
+func (s *Server) removeImage(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	force, _ := strconv.ParseBool(req.FormValue("force"))
+	noPrune, _ := strconv.ParseBool(req.FormValue("noprune"))
+
+	items, err := s.ImageMgr.Remove(ctx, name, force, noPrune)
+	if err != nil {
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, items)
+}
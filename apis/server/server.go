@@ -0,0 +1,9 @@
+package server
+
+import "github.com/alibaba/pouch/daemon/mgr"
+
+// Server dispatches HTTP API requests to the corresponding manager.
+type Server struct {
+	ImageMgr     *mgr.ImageManager
+	ContainerMgr *mgr.ContainerManager
+}
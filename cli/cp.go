@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alibaba/pouch/client"
+	"github.com/docker/docker/pkg/archive"
+	"github.com/spf13/cobra"
+)
+
+var cpDescription = "Copy files/folders between a container and the local filesystem. " +
+	"Use 'pouch cp CONTAINER:SRC_PATH DEST_PATH' to copy out of a container, " +
+	"or 'pouch cp SRC_PATH CONTAINER:DEST_PATH' to copy into one."
+
+// CopyCommand use to implement 'cp' command, it copies files/folders between
+// a container and the local filesystem.
+type CopyCommand struct {
+	baseCommand
+	noOverwriteDirNonDir bool
+}
+
+// Init initializes cp command.
+func (cp *CopyCommand) Init(c *Cli) {
+	cp.cli = c
+	cp.cmd = &cobra.Command{
+		Use:   "cp CONTAINER:SRC_PATH|SRC_PATH CONTAINER:DEST_PATH|DEST_PATH",
+		Short: "Copy files/folders between a container and the local filesystem",
+		Long:  cpDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cp.runCopy(args[0], args[1])
+		},
+		Example: cpExample(),
+	}
+	cp.addFlags()
+}
+
+// addFlags adds flags for specific command
+func (cp *CopyCommand) addFlags() {
+	cp.cmd.Flags().BoolVar(&cp.noOverwriteDirNonDir, "no-overwrite-dir-non-dir", false,
+		"do not allow to overwrite a directory with a non-directory and vice versa")
+}
+
+// runCopy is the entry of cp command.
+func (cp *CopyCommand) runCopy(src, dst string) error {
+	srcContainer, srcPath := splitCpArg(src)
+	dstContainer, dstPath := splitCpArg(dst)
+
+	switch {
+	case srcContainer != "" && dstContainer != "":
+		return fmt.Errorf("copying directly between two containers is not supported, copy to the local filesystem first")
+	case srcContainer != "":
+		return cp.copyFromContainer(srcContainer, srcPath, dstPath)
+	case dstContainer != "":
+		return cp.copyToContainer(srcPath, dstContainer, dstPath)
+	default:
+		return fmt.Errorf("one of src or dest must be a container path in the form CONTAINER:PATH")
+	}
+}
+
+// splitCpArg splits "container:path" into (container, path). A bare local
+// path with no ':' is returned as ("", path).
+func splitCpArg(arg string) (container, path string) {
+	if i := strings.Index(arg, ":"); i > 0 {
+		return arg[:i], arg[i+1:]
+	}
+	return "", arg
+}
+
+// copyFromContainer copies srcPath out of container cname to dstPath on the
+// local filesystem.
+func (cp *CopyCommand) copyFromContainer(cname, srcPath, dstPath string) error {
+	apiClient := cp.cli.Client()
+
+	content, stat, err := apiClient.CopyFromContainer(context.Background(), cname, srcPath)
+	if err != nil {
+		return fmt.Errorf("failed to copy from container: %v", err)
+	}
+	defer content.Close()
+
+	srcInfo := archive.CopyInfo{
+		Path:   srcPath,
+		Exists: true,
+		IsDir:  stat.Mode.IsDir(),
+	}
+
+	dstInfo := archive.CopyInfo{Path: dstPath}
+	if fi, err := os.Stat(dstPath); err == nil {
+		dstInfo.Exists, dstInfo.IsDir = true, fi.IsDir()
+	}
+
+	dstDir, preparedArchive, err := archive.PrepareArchiveCopy(content, srcInfo, dstInfo)
+	if err != nil {
+		return fmt.Errorf("failed to copy from container: %v", err)
+	}
+	defer preparedArchive.Close()
+
+	return archive.CopyTo(preparedArchive, srcInfo, dstDir)
+}
+
+// copyToContainer copies srcPath on the local filesystem into container
+// cname at dstPath.
+func (cp *CopyCommand) copyToContainer(srcPath string, cname, dstPath string) error {
+	apiClient := cp.cli.Client()
+
+	srcInfo, err := archive.CopyInfoSourcePath(srcPath, true)
+	if err != nil {
+		return fmt.Errorf("failed to copy to container: %v", err)
+	}
+
+	srcArchive, err := archive.TarResource(srcInfo)
+	if err != nil {
+		return fmt.Errorf("failed to copy to container: %v", err)
+	}
+	defer srcArchive.Close()
+
+	dstInfo := archive.CopyInfo{Path: dstPath}
+	if dstStat, err := apiClient.ContainerStatPath(context.Background(), cname, dstPath); err == nil {
+		dstInfo.Exists, dstInfo.IsDir = true, dstStat.Mode.IsDir()
+	}
+
+	dstDir, preparedArchive, err := archive.PrepareArchiveCopy(srcArchive, srcInfo, dstInfo)
+	if err != nil {
+		return fmt.Errorf("failed to copy to container: %v", err)
+	}
+	defer preparedArchive.Close()
+
+	return apiClient.CopyToContainer(context.Background(), cname, dstDir, preparedArchive, client.CopyToContainerOptions{
+		NoOverwriteDirNonDir: cp.noOverwriteDirNonDir,
+	})
+}
+
+// cpExample shows examples in cp command, and is used in auto-generated cli docs.
+func cpExample() string {
+	return `$ pouch cp test:/etc/hostname .
+$ pouch cp ./hostname test:/etc/hostname
+`
+}
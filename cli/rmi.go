@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/reference"
 	"github.com/spf13/cobra"
 )
@@ -14,7 +16,8 @@ var rmiDescription = "Remove one or more images by reference." +
 // RmiCommand use to implement 'rmi' command, it remove one or more images by reference
 type RmiCommand struct {
 	baseCommand
-	force bool
+	force   bool
+	noPrune bool
 }
 
 // Init initialize rmi command
@@ -36,6 +39,7 @@ func (rmi *RmiCommand) Init(c *Cli) {
 // addFlags adds flags for specific command
 func (rmi *RmiCommand) addFlags() {
 	rmi.cmd.Flags().BoolVarP(&rmi.force, "force", "f", false, "if image is being used, remove image and all associated resources")
+	rmi.cmd.Flags().BoolVar(&rmi.noPrune, "no-prune", false, "do not delete untagged parents")
 }
 
 // runRmi is the entry of rmi command
@@ -48,19 +52,38 @@ func (rmi *RmiCommand) runRmi(args []string) error {
 			return fmt.Errorf("failed to remove image: %v", err)
 		}
 
-		if err := apiClient.ImageRemove(ref.String(), rmi.force); err != nil {
+		items, err := apiClient.ImageRemove(context.Background(), ref.String(), rmi.force, rmi.noPrune)
+		if err != nil {
 			return fmt.Errorf("failed to remove image: %v", err)
 		}
-		fmt.Printf("%s\n", ref.String())
+		printImageDeleteItems(items)
 	}
 
 	return nil
 }
 
+// printImageDeleteItems renders the untag/delete results of a single
+// ImageRemove call the same way Docker's rmi does: one "Untagged:" line per
+// reference that stopped pointing at the image, followed by one "Deleted:"
+// line per image ID actually removed.
+func printImageDeleteItems(items []types.ImageDeleteResponseItem) {
+	for _, item := range items {
+		if item.Untagged != "" {
+			fmt.Printf("Untagged: %s\n", item.Untagged)
+		}
+	}
+	for _, item := range items {
+		if item.Deleted != "" {
+			fmt.Printf("Deleted: %s\n", item.Deleted)
+		}
+	}
+}
+
 // rmiExample shows examples in rmi command, and is used in auto-generated cli docs.
 func rmiExample() string {
 	return `$ pouch rmi registry.hub.docker.com/library/busybox:latest
-registry.hub.docker.com/library/busybox:latest
+Untagged: registry.hub.docker.com/library/busybox:latest
+Deleted: sha256:5b0d59026729b68570d99438d0afc4520196ee0d615d0b34870c71f1caa77e5f
 $ pouch create --name test registry.hub.docker.com/library/busybox:latest
 container ID: e5952417f9ee94621bbeaec532be1803ae2dedeb11a80f578a6d621e04a95afd, name: test
 $ pouch rmi registry.hub.docker.com/library/busybox:latest
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// containerPathStatHeader is the header the daemon uses to carry a
+// base64-encoded, JSON-marshalled types.ContainerPathStat alongside archive
+// responses, mirroring Docker's X-Docker-Container-Path-Stat.
+const containerPathStatHeader = "X-Docker-Container-Path-Stat"
+
+// CopyToContainerOptions holds the query flags accepted by the
+// /containers/{name}/archive PUT endpoint.
+type CopyToContainerOptions struct {
+	// NoOverwriteDirNonDir rejects extracting an entry that would
+	// overwrite a directory with a non-directory, or vice versa.
+	NoOverwriteDirNonDir bool
+}
+
+// CopyFromContainer streams path out of the container named name as a tar
+// archive, along with the stat of path itself. The caller must Close the
+// returned reader.
+func (client *APIClient) CopyFromContainer(ctx context.Context, name, path string) (io.ReadCloser, types.ContainerPathStat, error) {
+	q := url.Values{}
+	q.Add("path", path)
+
+	resp, err := client.do(ctx, http.MethodGet, "/containers/"+name+"/archive", q, nil, nil)
+	if err != nil {
+		return nil, types.ContainerPathStat{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, types.ContainerPathStat{}, checkResp(resp, http.StatusOK, nil)
+	}
+
+	stat, err := statFromHeader(resp.Header)
+	if err != nil {
+		resp.Body.Close()
+		return nil, types.ContainerPathStat{}, err
+	}
+	return resp.Body, stat, nil
+}
+
+// CopyToContainer extracts content at path inside the container named name,
+// streaming it directly without buffering the whole archive in memory.
+func (client *APIClient) CopyToContainer(ctx context.Context, name, path string, content io.Reader, opts CopyToContainerOptions) error {
+	q := url.Values{}
+	q.Add("path", path)
+	if opts.NoOverwriteDirNonDir {
+		q.Add("noOverwriteDirNonDir", "true")
+	}
+
+	resp, err := client.do(ctx, http.MethodPut, "/containers/"+name+"/archive", q, content, nil)
+	if err != nil {
+		return err
+	}
+	return checkResp(resp, http.StatusOK, nil)
+}
+
+// ContainerStatPath returns the stat of path inside the container named
+// name without transferring its contents.
+func (client *APIClient) ContainerStatPath(ctx context.Context, name, path string) (types.ContainerPathStat, error) {
+	q := url.Values{}
+	q.Add("path", path)
+
+	resp, err := client.do(ctx, http.MethodHead, "/containers/"+name+"/archive", q, nil, nil)
+	if err != nil {
+		return types.ContainerPathStat{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return types.ContainerPathStat{}, checkResp(resp, http.StatusOK, nil)
+	}
+	return statFromHeader(resp.Header)
+}
+
+// statFromHeader decodes the containerPathStatHeader carried on archive
+// responses.
+func statFromHeader(h http.Header) (types.ContainerPathStat, error) {
+	encoded := h.Get(containerPathStatHeader)
+	if encoded == "" {
+		return types.ContainerPathStat{}, fmt.Errorf("response is missing %s header", containerPathStatHeader)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return types.ContainerPathStat{}, fmt.Errorf("failed to decode %s header: %v", containerPathStatHeader, err)
+	}
+
+	var stat types.ContainerPathStat
+	if err := json.Unmarshal(raw, &stat); err != nil {
+		return types.ContainerPathStat{}, fmt.Errorf("failed to unmarshal %s header: %v", containerPathStatHeader, err)
+	}
+	return stat, nil
+}
@@ -0,0 +1,94 @@
+// Package client implements a Go client for the pouchd API. It is used by
+// the pouch CLI (and can be used by other Go programs) to talk to the
+// daemon over its HTTP API.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/errdefs"
+)
+
+// DefaultSockPath is the default daemon listening address used when no
+// host is supplied to NewAPIClient.
+const DefaultSockPath = "/var/run/pouchd.sock"
+
+// APIClient is the Go client used to communicate with the pouchd API.
+type APIClient struct {
+	addr    string
+	httpCli *http.Client
+}
+
+// NewAPIClient creates a new APIClient talking to the daemon at addr. An
+// empty addr falls back to DefaultSockPath.
+func NewAPIClient(addr string) *APIClient {
+	if addr == "" {
+		addr = DefaultSockPath
+	}
+	return &APIClient{
+		addr: addr,
+		httpCli: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					d := net.Dialer{Timeout: 30 * time.Second}
+					return d.DialContext(ctx, "unix", addr)
+				},
+			},
+		},
+	}
+}
+
+// do issues method against path with the given query and body, returning
+// the raw *http.Response for callers that need more than status+JSON (e.g.
+// archive endpoints reading the response as a stream).
+func (client *APIClient) do(ctx context.Context, method, path string, query url.Values, body io.Reader, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequest(method, "http://d"+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if query != nil {
+		req.URL.RawQuery = query.Encode()
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return client.httpCli.Do(req)
+}
+
+// checkResp decodes resp.Body into out on wantStatus, or translates the
+// response into an errdefs-typed error otherwise so callers can classify
+// the failure with errdefs.IsXxx instead of matching on the error message.
+// out may be nil for responses with no body (e.g. 204 No Content).
+func checkResp(resp *http.Response, wantStatus int, out interface{}) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode != wantStatus {
+		got := types.Error{}
+		_ = json.NewDecoder(resp.Body).Decode(&got)
+		return errdefs.FromStatusCode(errorFromMessage(got.Message), resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+type messageError string
+
+func (e messageError) Error() string { return string(e) }
+
+func errorFromMessage(msg string) error {
+	if msg == "" {
+		msg = "unknown error"
+	}
+	return messageError(msg)
+}
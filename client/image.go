@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ImageRemove removes the image referenced by ref. When the image has
+// multiple tags/repo names pointing at the same image ID, the daemon
+// untags ref and only deletes the underlying image once its last
+// reference is removed; force allows removing an image still used by a
+// container, and noPrune suppresses deletion of now-untagged parent
+// images. The returned slice holds one item per reference untagged and per
+// image ID actually deleted, in that order, matching Docker's rmi output.
+func (client *APIClient) ImageRemove(ctx context.Context, ref string, force, noPrune bool) ([]types.ImageDeleteResponseItem, error) {
+	q := url.Values{}
+	if force {
+		q.Add("force", "true")
+	}
+	if noPrune {
+		q.Add("noprune", "true")
+	}
+
+	resp, err := client.do(ctx, http.MethodDelete, "/images/"+ref, q, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []types.ImageDeleteResponseItem
+	if err := checkResp(resp, http.StatusOK, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
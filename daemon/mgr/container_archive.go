@@ -0,0 +1,86 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/errdefs"
+)
+
+// ContainerFilesystem abstracts the subset of a running container's merged
+// filesystem that archive operations need.
+type ContainerFilesystem interface {
+	// ResolvePath resolves path inside the container named id, following
+	// symlinks the same way a process inside the container would, and
+	// returns the equivalent host path plus whether that host path sits
+	// under a writable mount. err is an errdefs.NotFound-classified error
+	// when id doesn't name a known container.
+	ResolvePath(id, path string) (hostPath string, writable bool, err error)
+	// ReadOnlyRootfs reports whether the container's rootfs was created
+	// read-only.
+	ReadOnlyRootfs(id string) bool
+	// Stat stats hostPath on the host filesystem.
+	Stat(hostPath string) (types.ContainerPathStat, error)
+	// ReadTar returns a tar stream of hostPath. The caller must Close it.
+	ReadTar(hostPath string) (io.ReadCloser, error)
+	// ExtractTar extracts src into hostPath, rejecting an entry that would
+	// overwrite a directory with a non-directory (or vice versa) when
+	// noOverwriteDirNonDir is true.
+	ExtractTar(hostPath string, src io.Reader, noOverwriteDirNonDir bool) error
+}
+
+// ContainerManager handles container-related requests from the API,
+// including the /containers/{name}/archive endpoints used by `pouch cp`.
+type ContainerManager struct {
+	FS ContainerFilesystem
+}
+
+// StatPath stats path inside the container named id.
+func (mgr *ContainerManager) StatPath(ctx context.Context, id, path string) (types.ContainerPathStat, error) {
+	hostPath, _, err := mgr.FS.ResolvePath(id, path)
+	if err != nil {
+		return types.ContainerPathStat{}, err
+	}
+	return mgr.FS.Stat(hostPath)
+}
+
+// ArchivePath streams path out of the container named id as a tar archive,
+// along with the stat of path itself. The caller must Close the returned
+// reader.
+func (mgr *ContainerManager) ArchivePath(ctx context.Context, id, path string) (io.ReadCloser, types.ContainerPathStat, error) {
+	hostPath, _, err := mgr.FS.ResolvePath(id, path)
+	if err != nil {
+		return nil, types.ContainerPathStat{}, err
+	}
+
+	stat, err := mgr.FS.Stat(hostPath)
+	if err != nil {
+		return nil, types.ContainerPathStat{}, err
+	}
+
+	tarStream, err := mgr.FS.ReadTar(hostPath)
+	if err != nil {
+		return nil, types.ContainerPathStat{}, err
+	}
+	return tarStream, stat, nil
+}
+
+// ExtractToDir extracts src at path inside the container named id. If the
+// container's rootfs is read-only, path must resolve - following any
+// symlink the same way the in-container process would see it - into a
+// writable mount, otherwise the write is rejected rather than silently
+// landing on a throwaway overlay layer.
+func (mgr *ContainerManager) ExtractToDir(ctx context.Context, id, path string, src io.Reader, noOverwriteDirNonDir bool) error {
+	hostPath, writable, err := mgr.FS.ResolvePath(id, path)
+	if err != nil {
+		return err
+	}
+
+	if mgr.FS.ReadOnlyRootfs(id) && !writable {
+		return errdefs.Forbidden(fmt.Errorf("container %s has a read-only rootfs: %s does not resolve into a writable mount", id, path))
+	}
+
+	return mgr.FS.ExtractTar(hostPath, src, noOverwriteDirNonDir)
+}
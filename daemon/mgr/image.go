@@ -0,0 +1,90 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/errdefs"
+)
+
+// ImageStore abstracts the durable store of local images, keyed by image ID
+// and holding every reference (repo:tag) that currently resolves to it.
+type ImageStore interface {
+	// Resolve looks up idOrRef, reporting the image ID it resolves to, the
+	// reference itself when idOrRef was a repo:tag (byID is false), and
+	// whether idOrRef was an ID/digest rather than a reference. err is an
+	// errdefs.NotFound-classified error when idOrRef doesn't resolve to any
+	// image.
+	Resolve(idOrRef string) (id, ref string, byID bool, err error)
+	// References returns every reference currently pointing at id.
+	References(id string) []string
+	// RemoveReference removes ref from id's reference set.
+	RemoveReference(id, ref string) error
+	// Delete removes the image with id from the store entirely. When
+	// noPrune is true, now-dangling parent layers are left in place.
+	Delete(id string, noPrune bool) error
+}
+
+// ContainerIndex is the subset of the container manager that ImageManager
+// needs to check whether an image is still in use.
+type ContainerIndex interface {
+	// ListByImage returns the IDs of containers created from image id.
+	ListByImage(id string) []string
+}
+
+// ImageManager handles image-related requests from the API.
+type ImageManager struct {
+	ImageStore ImageStore
+	Containers ContainerIndex
+}
+
+// Remove untags idOrRef from the image it resolves to, deleting the image
+// once its last reference is gone. Removing one tag of a multi-tagged image
+// only untags it; removing the last tag deletes the image. force allows
+// removing an image still used by a container (untagging every remaining
+// reference and deleting it outright) and is required to remove by ID when
+// more than one tag still points at the image. noPrune suppresses deletion
+// of now-dangling parent layers.
+func (mgr *ImageManager) Remove(ctx context.Context, idOrRef string, force, noPrune bool) ([]types.ImageDeleteResponseItem, error) {
+	id, ref, byID, err := mgr.ImageStore.Resolve(idOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := mgr.ImageStore.References(id)
+	if byID && len(refs) > 1 && !force {
+		return nil, errdefs.Conflict(fmt.Errorf("conflict: unable to delete %s (must be forced) - image is referenced in multiple repositories", id))
+	}
+
+	containers := mgr.Containers.ListByImage(id)
+	if len(containers) > 0 && !force {
+		return nil, errdefs.Conflict(fmt.Errorf("unable to remove the image %q (must force) - container %s is using this image", idOrRef, containers[0]))
+	}
+
+	// Removing a single tag of a multi-tagged image only untags it.
+	// Removing by ID when multiple tags remain (only possible with force,
+	// checked above) and forcing removal of an in-use image both untag
+	// every reference so the image can be deleted outright.
+	untag := []string{ref}
+	if byID || (force && len(containers) > 0) {
+		untag = refs
+	}
+
+	var items []types.ImageDeleteResponseItem
+	for _, r := range untag {
+		if err := mgr.ImageStore.RemoveReference(id, r); err != nil {
+			return nil, err
+		}
+		items = append(items, types.ImageDeleteResponseItem{Untagged: r})
+	}
+
+	if len(mgr.ImageStore.References(id)) == 0 {
+		if err := mgr.ImageStore.Delete(id, noPrune); err != nil {
+			return nil, err
+		}
+		items = append(items, types.ImageDeleteResponseItem{Deleted: id})
+	}
+
+	return items, nil
+}
@@ -0,0 +1,49 @@
+// Package errdefs defines a set of error interfaces that pouch uses to
+// classify errors across package boundaries, so that callers on either side
+// of an API - the daemon's HTTP routers, the API client, the CLI - can
+// branch on what kind of failure occurred instead of matching on an error
+// message string. It mirrors the pattern used by moby/moby's errdefs
+// package.
+package errdefs
+
+// ErrNotFound signals that the requested object does not exist.
+type ErrNotFound interface {
+	NotFound()
+}
+
+// ErrInvalidParameter signals that the user input is invalid.
+type ErrInvalidParameter interface {
+	InvalidParameter()
+}
+
+// ErrConflict signals that the requested operation can't be completed
+// because of a conflict with the current state of the target resource.
+type ErrConflict interface {
+	Conflict()
+}
+
+// ErrUnauthorized signals that the user is not authenticated.
+type ErrUnauthorized interface {
+	Unauthorized()
+}
+
+// ErrForbidden signals that the requested action is not allowed for
+// authorization reasons.
+type ErrForbidden interface {
+	Forbidden()
+}
+
+// ErrNotImplemented signals that the requested action is not supported.
+type ErrNotImplemented interface {
+	NotImplemented()
+}
+
+// ErrUnavailable signals that the requested action isn't available.
+type ErrUnavailable interface {
+	Unavailable()
+}
+
+// ErrSystem signals an unrecoverable system error.
+type ErrSystem interface {
+	System()
+}
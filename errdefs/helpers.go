@@ -0,0 +1,105 @@
+package errdefs
+
+type errNotFound struct{ error }
+
+func (errNotFound) NotFound() {}
+
+// NotFound wraps err so that IsNotFound reports true for it. It returns nil
+// if err is nil.
+func NotFound(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errNotFound{err}
+}
+
+type errInvalidParameter struct{ error }
+
+func (errInvalidParameter) InvalidParameter() {}
+
+// InvalidParameter wraps err so that IsInvalidParameter reports true for it.
+// It returns nil if err is nil.
+func InvalidParameter(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errInvalidParameter{err}
+}
+
+type errConflict struct{ error }
+
+func (errConflict) Conflict() {}
+
+// Conflict wraps err so that IsConflict reports true for it. It returns nil
+// if err is nil.
+func Conflict(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errConflict{err}
+}
+
+type errUnauthorized struct{ error }
+
+func (errUnauthorized) Unauthorized() {}
+
+// Unauthorized wraps err so that IsUnauthorized reports true for it. It
+// returns nil if err is nil.
+func Unauthorized(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errUnauthorized{err}
+}
+
+type errForbidden struct{ error }
+
+func (errForbidden) Forbidden() {}
+
+// Forbidden wraps err so that IsForbidden reports true for it. It returns
+// nil if err is nil.
+func Forbidden(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errForbidden{err}
+}
+
+type errNotImplemented struct{ error }
+
+func (errNotImplemented) NotImplemented() {}
+
+// NotImplemented wraps err so that IsNotImplemented reports true for it. It
+// returns nil if err is nil.
+func NotImplemented(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errNotImplemented{err}
+}
+
+type errUnavailable struct{ error }
+
+func (errUnavailable) Unavailable() {}
+
+// Unavailable wraps err so that IsUnavailable reports true for it. It
+// returns nil if err is nil.
+func Unavailable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errUnavailable{err}
+}
+
+type errSystem struct{ error }
+
+func (errSystem) System() {}
+
+// System wraps err so that IsSystem reports true for it. It returns nil if
+// err is nil.
+func System(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errSystem{err}
+}
@@ -0,0 +1,65 @@
+package errdefs
+
+import "net/http"
+
+// GetHTTPErrorStatusCode returns the HTTP status code that daemon routers
+// should write for err, so that status codes are derived from an error's
+// errdefs classification in one place instead of being picked ad hoc at
+// each call site.
+func GetHTTPErrorStatusCode(err error) int {
+	if err == nil {
+		return http.StatusInternalServerError
+	}
+
+	switch {
+	case IsNotFound(err):
+		return http.StatusNotFound
+	case IsInvalidParameter(err):
+		return http.StatusBadRequest
+	case IsConflict(err):
+		return http.StatusConflict
+	case IsUnauthorized(err):
+		return http.StatusUnauthorized
+	case IsForbidden(err):
+		return http.StatusForbidden
+	case IsNotImplemented(err):
+		return http.StatusNotImplemented
+	case IsUnavailable(err):
+		return http.StatusServiceUnavailable
+	case IsSystem(err):
+		return http.StatusInternalServerError
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// FromStatusCode wraps err with the errdefs type corresponding to
+// statusCode, the reverse of GetHTTPErrorStatusCode. API clients use it to
+// translate a response's status code back into a typed error that callers
+// can classify with the Is* helpers.
+func FromStatusCode(err error, statusCode int) error {
+	if err == nil {
+		return nil
+	}
+
+	switch statusCode {
+	case http.StatusNotFound:
+		return NotFound(err)
+	case http.StatusBadRequest:
+		return InvalidParameter(err)
+	case http.StatusConflict:
+		return Conflict(err)
+	case http.StatusUnauthorized:
+		return Unauthorized(err)
+	case http.StatusForbidden:
+		return Forbidden(err)
+	case http.StatusNotImplemented:
+		return NotImplemented(err)
+	case http.StatusServiceUnavailable:
+		return Unavailable(err)
+	case http.StatusInternalServerError:
+		return System(err)
+	default:
+		return err
+	}
+}
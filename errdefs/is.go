@@ -0,0 +1,98 @@
+package errdefs
+
+// causer is implemented by the wrapped-error types from
+// github.com/pkg/errors, letting us walk to the underlying cause of an
+// error that was wrapped with errors.Wrap/errors.Wrapf.
+type causer interface {
+	Cause() error
+}
+
+// matches walks err and, if it doesn't satisfy check, its Cause() chain,
+// checking each error in turn. The error itself is always checked before
+// unwrapping to its cause, so a typed wrapper applied closer to the
+// original error takes precedence over one found further down the chain.
+func matches(err error, check func(error) bool) bool {
+	for err != nil {
+		if check(err) {
+			return true
+		}
+		c, ok := err.(causer)
+		if !ok {
+			return false
+		}
+		err = c.Cause()
+	}
+	return false
+}
+
+// IsNotFound returns true if err, or any error in its Cause() chain,
+// implements ErrNotFound.
+func IsNotFound(err error) bool {
+	return matches(err, func(err error) bool {
+		_, ok := err.(ErrNotFound)
+		return ok
+	})
+}
+
+// IsInvalidParameter returns true if err, or any error in its Cause()
+// chain, implements ErrInvalidParameter.
+func IsInvalidParameter(err error) bool {
+	return matches(err, func(err error) bool {
+		_, ok := err.(ErrInvalidParameter)
+		return ok
+	})
+}
+
+// IsConflict returns true if err, or any error in its Cause() chain,
+// implements ErrConflict.
+func IsConflict(err error) bool {
+	return matches(err, func(err error) bool {
+		_, ok := err.(ErrConflict)
+		return ok
+	})
+}
+
+// IsUnauthorized returns true if err, or any error in its Cause() chain,
+// implements ErrUnauthorized.
+func IsUnauthorized(err error) bool {
+	return matches(err, func(err error) bool {
+		_, ok := err.(ErrUnauthorized)
+		return ok
+	})
+}
+
+// IsForbidden returns true if err, or any error in its Cause() chain,
+// implements ErrForbidden.
+func IsForbidden(err error) bool {
+	return matches(err, func(err error) bool {
+		_, ok := err.(ErrForbidden)
+		return ok
+	})
+}
+
+// IsNotImplemented returns true if err, or any error in its Cause() chain,
+// implements ErrNotImplemented.
+func IsNotImplemented(err error) bool {
+	return matches(err, func(err error) bool {
+		_, ok := err.(ErrNotImplemented)
+		return ok
+	})
+}
+
+// IsUnavailable returns true if err, or any error in its Cause() chain,
+// implements ErrUnavailable.
+func IsUnavailable(err error) bool {
+	return matches(err, func(err error) bool {
+		_, ok := err.(ErrUnavailable)
+		return ok
+	})
+}
+
+// IsSystem returns true if err, or any error in its Cause() chain,
+// implements ErrSystem.
+func IsSystem(err error) bool {
+	return matches(err, func(err error) bool {
+		_, ok := err.(ErrSystem)
+		return ok
+	})
+}
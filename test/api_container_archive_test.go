@@ -0,0 +1,59 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+
+	"github.com/go-check/check"
+)
+
+func init() {
+	check.Suite(&APIContainerArchiveSuite{})
+}
+
+// APIContainerArchiveSuite tests the /containers/{name}/archive endpoints
+// used by `pouch cp`.
+type APIContainerArchiveSuite struct{}
+
+// TestPutThenGetRoundTrips extracts a small tar archive into a running
+// container, then reads it back out and asserts the content matches.
+func (suite *APIContainerArchiveSuite) TestPutThenGetRoundTrips(c *check.C) {
+	cname := "TestPutThenGetRoundTrips"
+	CreateBusyboxContainerOk(c, cname)
+	StartContainerOk(c, cname)
+	defer DelContainerForceOk(c, cname)
+
+	const content = "hello from pouch cp\n"
+	PutContainerArchiveOk(c, cname, "/tmp/", buildSingleFileTar(c, "hello.txt", content))
+
+	stat := StatContainerPathOk(c, cname, "/tmp/hello.txt")
+	c.Assert(stat.Name, check.Equals, "hello.txt")
+
+	rc, _ := GetContainerArchiveOk(c, cname, "/tmp/hello.txt")
+	defer rc.Close()
+
+	tr := tar.NewReader(rc)
+	hdr, err := tr.Next()
+	c.Assert(err, check.IsNil)
+	c.Assert(hdr.Name, check.Equals, "hello.txt")
+
+	got, err := ioutil.ReadAll(tr)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(got), check.Equals, content)
+}
+
+// buildSingleFileTar builds a single-file in-memory tar archive for use
+// with PutContainerArchiveOk.
+func buildSingleFileTar(c *check.C, name, content string) *bytes.Reader {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+
+	err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))})
+	c.Assert(err, check.IsNil)
+	_, err = tw.Write([]byte(content))
+	c.Assert(err, check.IsNil)
+	c.Assert(tw.Close(), check.IsNil)
+
+	return bytes.NewReader(buf.Bytes())
+}
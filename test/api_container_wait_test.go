@@ -0,0 +1,40 @@
+package main
+
+import (
+	"time"
+
+	"github.com/alibaba/pouch/test/poll"
+
+	"github.com/go-check/check"
+)
+
+func init() {
+	check.Suite(&APIContainerWaitSuite{})
+}
+
+// APIContainerWaitSuite composes WaitContainerState/WaitContainerExit across
+// a container's start/stop/pause/unpause lifecycle.
+type APIContainerWaitSuite struct{}
+
+// TestWaitThroughLifecycle drives a container through
+// start/pause/unpause/stop and asserts each transition is observed via
+// WaitContainerStateOk/WaitContainerExit before proceeding to the next one.
+func (suite *APIContainerWaitSuite) TestWaitThroughLifecycle(c *check.C) {
+	cname := "TestWaitThroughLifecycle"
+	CreateBusyboxContainerOk(c, cname, "sleep", "600")
+	defer DelContainerForceOk(c, cname)
+
+	StartContainerOk(c, cname)
+	WaitContainerStateOk(c, cname, "running")
+
+	PauseContainerOk(c, cname)
+	WaitContainerStateOk(c, cname, "paused", poll.WithTimeout(5*time.Second), poll.WithDelay(50*time.Millisecond))
+
+	UnpauseContainerOk(c, cname)
+	WaitContainerStateOk(c, cname, "running")
+
+	StopContainerOk(c, cname)
+	exitCode, err := WaitContainerExit(c, cname)
+	c.Assert(err, check.IsNil)
+	c.Assert(exitCode, check.Not(check.Equals), int64(0))
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/alibaba/pouch/errdefs"
+	"github.com/alibaba/pouch/test/request"
+
+	"github.com/go-check/check"
+)
+
+func init() {
+	check.Suite(&APIErrdefsSuite{})
+}
+
+// APIErrdefsSuite verifies that daemon routers map errdefs-classified errors
+// to the matching HTTP status code in one place, so every endpoint's
+// failures can be classified the same way on the client side.
+type APIErrdefsSuite struct{}
+
+// TestRemoveByIDWithMultipleTagsReturnsConflict verifies that DELETE
+// /images/{name} on an ambiguous by-ID reference surfaces as a conflict at
+// the raw HTTP layer, not just through the typed client.
+func (suite *APIErrdefsSuite) TestRemoveByIDWithMultipleTagsReturnsConflict(c *check.C) {
+	tag := busyboxImage + "-errdefs-conflict"
+	TagImageOk(c, busyboxImage, tag)
+	defer DelImageForceOk(c, tag)
+
+	info, err := apiClient.ImageInspect(context.Background(), busyboxImage)
+	c.Assert(err, check.IsNil)
+
+	resp, err := request.Delete("/images/" + info.ID)
+	c.Assert(err, check.IsNil)
+	CheckRespError(c, resp, errdefs.IsConflict)
+}
+
+// TestRemoveUnknownImageReturnsNotFound verifies that DELETE /images/{name}
+// for a reference that doesn't exist surfaces as not-found.
+func (suite *APIErrdefsSuite) TestRemoveUnknownImageReturnsNotFound(c *check.C) {
+	resp, err := request.Delete("/images/does-not-exist:latest")
+	c.Assert(err, check.IsNil)
+	CheckRespError(c, resp, errdefs.IsNotFound)
+}
+
+// TestHeadArchiveUnknownContainerReturnsNotFound verifies that HEAD
+// /containers/{name}/archive for an unknown container surfaces as
+// not-found, the same as every other container endpoint.
+func (suite *APIErrdefsSuite) TestHeadArchiveUnknownContainerReturnsNotFound(c *check.C) {
+	q := url.Values{}
+	q.Add("path", "/tmp")
+
+	resp, err := request.Head("/containers/does-not-exist/archive", request.WithQuery(q))
+	c.Assert(err, check.IsNil)
+	CheckRespError(c, resp, errdefs.IsNotFound)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/errdefs"
+
+	"github.com/go-check/check"
+)
+
+func init() {
+	check.Suite(&APIImageRemoveSuite{})
+}
+
+// APIImageRemoveSuite tests the DELETE /images/{name} API's Docker-compatible
+// multi-reference untag/delete semantics.
+type APIImageRemoveSuite struct{}
+
+// TestRemoveOneOfMultipleTagsOnlyUntags verifies that removing one tag of a
+// multi-tagged image only untags it, leaving the image and its other tags
+// in place.
+func (suite *APIImageRemoveSuite) TestRemoveOneOfMultipleTagsOnlyUntags(c *check.C) {
+	extraTag := busyboxImage + "-extra-tag"
+	TagImageOk(c, busyboxImage, extraTag)
+	defer DelImageForceOk(c, extraTag)
+
+	items, err := apiClient.ImageRemove(context.Background(), extraTag, false, false)
+	c.Assert(err, check.IsNil)
+	c.Assert(items, check.HasLen, 1)
+	c.Assert(items[0].Untagged, check.Equals, extraTag)
+	c.Assert(items[0].Deleted, check.Equals, "")
+}
+
+// TestRemoveLastTagDeletesImage verifies that removing the last reference to
+// an image also deletes the image itself.
+func (suite *APIImageRemoveSuite) TestRemoveLastTagDeletesImage(c *check.C) {
+	tag := busyboxImage + "-last-tag"
+	TagImageOk(c, busyboxImage, tag)
+
+	// Untag busyboxImage so that tag is the image's last reference.
+	_, err := apiClient.ImageRemove(context.Background(), busyboxImage, false, false)
+	c.Assert(err, check.IsNil)
+
+	items, err := apiClient.ImageRemove(context.Background(), tag, false, false)
+	c.Assert(err, check.IsNil)
+	c.Assert(items, check.HasLen, 2)
+	c.Assert(items[0].Untagged, check.Equals, tag)
+	c.Assert(items[1].Deleted, check.Not(check.Equals), "")
+}
+
+// TestForceRemoveInUseImageUntagsAllAndDeletes verifies that -f on an image
+// used by a container untags every remaining reference and deletes it.
+func (suite *APIImageRemoveSuite) TestForceRemoveInUseImageUntagsAllAndDeletes(c *check.C) {
+	tag := busyboxImage + "-in-use"
+	TagImageOk(c, busyboxImage, tag)
+
+	cname := "TestForceRemoveInUseImageUntagsAllAndDeletes"
+	CreateBusyboxContainerOk(c, cname)
+	defer DelContainerForceOk(c, cname)
+
+	items, err := apiClient.ImageRemove(context.Background(), tag, true, false)
+	c.Assert(err, check.IsNil)
+
+	var deleted bool
+	for _, item := range items {
+		if item.Deleted != "" {
+			deleted = true
+		}
+	}
+	c.Assert(deleted, check.Equals, true)
+}
+
+// TestRemoveByIDWithMultipleTagsRequiresForce verifies that removing an
+// image by ID fails while it still has multiple tags, unless -f is given.
+func (suite *APIImageRemoveSuite) TestRemoveByIDWithMultipleTagsRequiresForce(c *check.C) {
+	tag := busyboxImage + "-by-id"
+	TagImageOk(c, busyboxImage, tag)
+	defer DelImageForceOk(c, tag)
+
+	info, err := apiClient.ImageInspect(context.Background(), busyboxImage)
+	c.Assert(err, check.IsNil)
+
+	_, err = apiClient.ImageRemove(context.Background(), info.ID, false, false)
+	c.Assert(err, check.NotNil)
+	c.Assert(errdefs.IsConflict(err), check.Equals, true)
+
+	items, err := apiClient.ImageRemove(context.Background(), info.ID, true, false)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(items) > 0, check.Equals, true)
+}
@@ -0,0 +1,115 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/test/request"
+)
+
+// containerPathStatHeader is the header the daemon uses to carry a
+// base64-encoded, JSON-marshalled types.ContainerPathStat alongside archive
+// responses, mirroring Docker's X-Docker-Container-Path-Stat.
+const containerPathStatHeader = "X-Docker-Container-Path-Stat"
+
+// PutOpt configures a PutContainerArchive call.
+type PutOpt func(*putConfig)
+
+type putConfig struct {
+	noOverwriteDirNonDir bool
+}
+
+// WithNoOverwriteDirNonDir rejects extracting an entry that would overwrite
+// a directory with a non-directory, or vice versa.
+func WithNoOverwriteDirNonDir() PutOpt {
+	return func(cfg *putConfig) {
+		cfg.noOverwriteDirNonDir = true
+	}
+}
+
+// GetContainerArchive streams path out of the container named name as a tar
+// archive, along with the stat of path itself. The caller must Close the
+// returned reader once done reading it, e.g. by wrapping it in
+// tar.NewReader and closing the reader separately.
+func (cli *APIClient) GetContainerArchive(ctx context.Context, name, path string) (io.ReadCloser, *types.ContainerPathStat, error) {
+	q := url.Values{}
+	q.Add("path", path)
+
+	resp, err := request.Get("/containers/"+name+"/archive", request.WithContext(ctx), request.WithQuery(q))
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, checkResp(resp, http.StatusOK, nil)
+	}
+
+	stat, err := decodePathStatHeader(resp.Header)
+	if err != nil {
+		resp.Body.Close()
+		return nil, nil, err
+	}
+	return resp.Body, stat, nil
+}
+
+// PutContainerArchive extracts tarStream at path inside the container named
+// name, streaming the archive directly without buffering it in memory.
+func (cli *APIClient) PutContainerArchive(ctx context.Context, name, path string, tarStream io.Reader, opts ...PutOpt) (*http.Response, error) {
+	cfg := &putConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	q := url.Values{}
+	q.Add("path", path)
+	if cfg.noOverwriteDirNonDir {
+		q.Add("noOverwriteDirNonDir", "true")
+	}
+
+	return request.Put("/containers/"+name+"/archive",
+		request.WithContext(ctx),
+		request.WithQuery(q),
+		request.WithRawBody(tarStream))
+}
+
+// StatContainerPath returns the stat of path inside the container named
+// name without transferring its contents.
+func (cli *APIClient) StatContainerPath(ctx context.Context, name, path string) (*types.ContainerPathStat, error) {
+	q := url.Values{}
+	q.Add("path", path)
+
+	resp, err := request.Head("/containers/"+name+"/archive", request.WithContext(ctx), request.WithQuery(q))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, checkResp(resp, http.StatusOK, nil)
+	}
+	defer resp.Body.Close()
+	return decodePathStatHeader(resp.Header)
+}
+
+// decodePathStatHeader decodes the containerPathStatHeader carried on
+// archive responses.
+func decodePathStatHeader(h http.Header) (*types.ContainerPathStat, error) {
+	encoded := h.Get(containerPathStatHeader)
+	if encoded == "" {
+		return nil, fmt.Errorf("response is missing %s header", containerPathStatHeader)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s header: %v", containerPathStatHeader, err)
+	}
+
+	stat := &types.ContainerPathStat{}
+	if err := json.Unmarshal(raw, stat); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s header: %v", containerPathStatHeader, err)
+	}
+	return stat, nil
+}
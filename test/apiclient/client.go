@@ -0,0 +1,44 @@
+// Package apiclient provides a typed client for the daemon's HTTP API, for
+// use by the integration test suites under test/. Unlike the ad-hoc
+// map[string]interface{} request bodies built directly against test/request,
+// APIClient exchanges the real github.com/alibaba/pouch/apis/types structs
+// used by the daemon, so a renamed or newly added field is caught at compile
+// time instead of silently being dropped from the request.
+package apiclient
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/errdefs"
+	"github.com/alibaba/pouch/test/request"
+)
+
+// APIClient is a typed wrapper around the test/request HTTP transport.
+type APIClient struct{}
+
+// NewAPIClient creates a new APIClient.
+func NewAPIClient() *APIClient {
+	return &APIClient{}
+}
+
+// checkResp decodes resp.Body into out on wantStatus, or translates the
+// response's status code and decoded types.Error into an errdefs-typed
+// error otherwise, so callers can classify the failure with errdefs.IsXxx
+// instead of matching on the error message. out may be nil for responses
+// with no body (e.g. 204 No Content).
+func checkResp(resp *http.Response, wantStatus int, out interface{}) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode != wantStatus {
+		got := types.Error{}
+		_ = request.DecodeBody(&got, resp.Body)
+		return errdefs.FromStatusCode(errors.New(got.Message), resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	return request.DecodeBody(out, resp.Body)
+}
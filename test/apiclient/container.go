@@ -0,0 +1,95 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/test/request"
+)
+
+// ContainerCreate creates a container named name from cfg.
+func (cli *APIClient) ContainerCreate(ctx context.Context, name string, cfg *types.ContainerCreateConfig) (*types.ContainerCreateResp, error) {
+	q := url.Values{}
+	q.Add("name", name)
+
+	resp, err := request.Post("/containers/create",
+		request.WithContext(ctx),
+		request.WithQuery(q),
+		request.WithJSONBody(cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	got := &types.ContainerCreateResp{}
+	if err := checkResp(resp, http.StatusCreated, got); err != nil {
+		return nil, err
+	}
+	return got, nil
+}
+
+// ContainerStart starts the container named name.
+func (cli *APIClient) ContainerStart(ctx context.Context, name string) error {
+	resp, err := request.Post("/containers/"+name+"/start", request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	return checkResp(resp, http.StatusNoContent, nil)
+}
+
+// ContainerStop stops the container named name.
+func (cli *APIClient) ContainerStop(ctx context.Context, name string) error {
+	resp, err := request.Post("/containers/"+name+"/stop", request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	return checkResp(resp, http.StatusNoContent, nil)
+}
+
+// ContainerPause pauses the container named name.
+func (cli *APIClient) ContainerPause(ctx context.Context, name string) error {
+	resp, err := request.Post("/containers/"+name+"/pause", request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	return checkResp(resp, http.StatusNoContent, nil)
+}
+
+// ContainerUnpause unpauses the container named name.
+func (cli *APIClient) ContainerUnpause(ctx context.Context, name string) error {
+	resp, err := request.Post("/containers/"+name+"/unpause", request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	return checkResp(resp, http.StatusNoContent, nil)
+}
+
+// ContainerRemove removes the container named name, forcing removal of a
+// running container when force is true.
+func (cli *APIClient) ContainerRemove(ctx context.Context, name string, force bool) error {
+	q := url.Values{}
+	if force {
+		q.Add("force", "true")
+	}
+
+	resp, err := request.Delete("/containers/"+name, request.WithContext(ctx), request.WithQuery(q))
+	if err != nil {
+		return err
+	}
+	return checkResp(resp, http.StatusNoContent, nil)
+}
+
+// ContainerInspect returns the inspect result of the container named name.
+func (cli *APIClient) ContainerInspect(ctx context.Context, name string) (*types.ContainerJSON, error) {
+	resp, err := request.Get("/containers/"+name+"/json", request.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	got := &types.ContainerJSON{}
+	if err := checkResp(resp, http.StatusOK, got); err != nil {
+		return nil, err
+	}
+	return got, nil
+}
@@ -0,0 +1,36 @@
+package apiclient
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/test/request"
+)
+
+// ContainerExecCreate creates an exec process in the container named name.
+func (cli *APIClient) ContainerExecCreate(ctx context.Context, name string, cfg *types.ExecCreateConfig) (*types.ExecCreateResp, error) {
+	resp, err := request.Post("/containers/"+name+"/exec",
+		request.WithContext(ctx),
+		request.WithJSONBody(cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	got := &types.ExecCreateResp{}
+	if err := checkResp(resp, http.StatusCreated, got); err != nil {
+		return nil, err
+	}
+	return got, nil
+}
+
+// ContainerExecStart starts the exec process execid and, for non-detached
+// execs, hijacks the connection for attach I/O.
+func (cli *APIClient) ContainerExecStart(ctx context.Context, execid string, cfg *types.ExecStartConfig) (*http.Response, net.Conn, *bufio.Reader, error) {
+	return request.Hijack("/exec/"+execid+"/start",
+		request.WithContext(ctx),
+		request.WithJSONBody(cfg),
+		request.WithHeader("Content-Type", "text/plain"))
+}
@@ -0,0 +1,62 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/test/request"
+)
+
+// ImageRemove removes the image referenced by ref, forcing removal when used
+// by a container if force is true. It returns one types.ImageDeleteResponseItem
+// per reference untagged or image ID actually deleted, mirroring Docker's
+// rmi semantics for multi-tagged images. Passing noPrune suppresses deletion
+// of now-untagged parent images.
+func (cli *APIClient) ImageRemove(ctx context.Context, ref string, force, noPrune bool) ([]types.ImageDeleteResponseItem, error) {
+	q := url.Values{}
+	if force {
+		q.Add("force", "true")
+	}
+	if noPrune {
+		q.Add("noprune", "true")
+	}
+
+	resp, err := request.Delete("/images/"+ref, request.WithContext(ctx), request.WithQuery(q))
+	if err != nil {
+		return nil, err
+	}
+
+	var got []types.ImageDeleteResponseItem
+	if err := checkResp(resp, http.StatusOK, &got); err != nil {
+		return nil, err
+	}
+	return got, nil
+}
+
+// ImageTag adds newTag as a new reference to the image referenced by ref.
+func (cli *APIClient) ImageTag(ctx context.Context, ref, newTag string) error {
+	q := url.Values{}
+	q.Add("repo", newTag)
+
+	resp, err := request.Post("/images/"+ref+"/tag", request.WithContext(ctx), request.WithQuery(q))
+	if err != nil {
+		return err
+	}
+	return checkResp(resp, http.StatusCreated, nil)
+}
+
+// ImageInspect returns the inspect result of the image referenced by ref.
+func (cli *APIClient) ImageInspect(ctx context.Context, ref string) (*types.ImageInfo, error) {
+	resp, err := request.Get("/images/"+ref+"/json", request.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	got := &types.ImageInfo{}
+	if err := checkResp(resp, http.StatusOK, got); err != nil {
+		return nil, err
+	}
+	return got, nil
+}
@@ -0,0 +1,17 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/alibaba/pouch/test/request"
+)
+
+// NetworkRemove removes the network named name.
+func (cli *APIClient) NetworkRemove(ctx context.Context, name string) error {
+	resp, err := request.Delete("/networks/"+name, request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	return checkResp(resp, http.StatusNoContent, nil)
+}
@@ -0,0 +1,77 @@
+// Package poll implements a small polling helper shared by the integration
+// test suites under test/ for waiting on eventually-consistent daemon state
+// (a container reaching a state, an image finishing a pull, and so on).
+package poll
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+	defaultDelay   = 100 * time.Millisecond
+)
+
+// Result is returned by a Check to tell Wait whether to keep polling, stop
+// successfully, or abort with an error.
+type Result struct {
+	done bool
+	err  error
+}
+
+// Continue reports that the condition has not been met yet.
+func Continue() Result { return Result{} }
+
+// Success reports that the condition has been met.
+func Success() Result { return Result{done: true} }
+
+// Error aborts polling immediately with err.
+func Error(err error) Result { return Result{done: true, err: err} }
+
+// Check is polled on an interval by Wait until it returns a done Result or
+// the timeout elapses.
+type Check func() Result
+
+// Option configures the timeout and polling interval used by Wait.
+type Option func(*config)
+
+type config struct {
+	timeout time.Duration
+	delay   time.Duration
+}
+
+// WithTimeout overrides Wait's default 30s timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *config) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithDelay overrides Wait's default 100ms polling interval.
+func WithDelay(delay time.Duration) Option {
+	return func(cfg *config) {
+		cfg.delay = delay
+	}
+}
+
+// Wait repeatedly invokes check until it reports done, returning its error,
+// or returns a timeout error including timeoutMsg once the timeout elapses.
+func Wait(timeoutMsg string, check Check, opts ...Option) error {
+	cfg := &config{timeout: defaultTimeout, delay: defaultDelay}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	deadline := time.Now().Add(cfg.timeout)
+	for {
+		result := check()
+		if result.done {
+			return result.err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s: %s", cfg.timeout, timeoutMsg)
+		}
+		time.Sleep(cfg.delay)
+	}
+}
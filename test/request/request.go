@@ -0,0 +1,166 @@
+// Package request is a thin HTTP transport used by the integration test
+// suites under test/ to talk to the pouchd daemon under test. Higher-level,
+// typed helpers live in test/apiclient; this package only knows how to build
+// and send a *http.Request and get a *http.Response back.
+package request
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"time"
+)
+
+// DefaultSockPath is the default daemon listening address used when
+// POUCH_HOST is not set in the test environment.
+const DefaultSockPath = "/var/run/pouchd.sock"
+
+// Option configures a single request built by Get/Post/Delete/Put/Head/Hijack.
+type Option func(*http.Request)
+
+// WithQuery sets the request's URL query string.
+func WithQuery(q url.Values) Option {
+	return func(req *http.Request) {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// WithHeader sets a request header.
+func WithHeader(key, value string) Option {
+	return func(req *http.Request) {
+		req.Header.Set(key, value)
+	}
+}
+
+// WithContext attaches ctx to the request, so callers can enforce a
+// per-call timeout or cancellation.
+func WithContext(ctx context.Context) Option {
+	return func(req *http.Request) {
+		*req = *req.WithContext(ctx)
+	}
+}
+
+// WithJSONBody marshals obj as the request body and sets the Content-Type
+// header to application/json.
+func WithJSONBody(obj interface{}) Option {
+	return func(req *http.Request) {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return
+		}
+		setBody(req, bytes.NewReader(data))
+		req.Header.Set("Content-Type", "application/json")
+	}
+}
+
+// WithRawBody streams r as the request body without buffering it in memory,
+// for callers sending large payloads such as a tar archive.
+func WithRawBody(r io.Reader) Option {
+	return func(req *http.Request) {
+		setBody(req, r)
+	}
+}
+
+func setBody(req *http.Request, r io.Reader) {
+	rc, ok := r.(io.ReadCloser)
+	if !ok && r != nil {
+		rc = io.NopCloser(r)
+	}
+	req.Body = rc
+	if rc != nil {
+		req.ContentLength = -1
+	}
+}
+
+func sockPath() string {
+	if addr := os.Getenv("POUCH_HOST"); addr != "" {
+		return addr
+	}
+	return DefaultSockPath
+}
+
+func newClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				d := net.Dialer{Timeout: 30 * time.Second}
+				return d.DialContext(ctx, "unix", sockPath())
+			},
+		},
+	}
+}
+
+func do(method, path string, opts ...Option) (*http.Response, error) {
+	req, err := http.NewRequest(method, "http://d"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+	return newClient().Do(req)
+}
+
+// Get issues a GET request against path.
+func Get(path string, opts ...Option) (*http.Response, error) {
+	return do(http.MethodGet, path, opts...)
+}
+
+// Post issues a POST request against path.
+func Post(path string, opts ...Option) (*http.Response, error) {
+	return do(http.MethodPost, path, opts...)
+}
+
+// Put issues a PUT request against path.
+func Put(path string, opts ...Option) (*http.Response, error) {
+	return do(http.MethodPut, path, opts...)
+}
+
+// Delete issues a DELETE request against path.
+func Delete(path string, opts ...Option) (*http.Response, error) {
+	return do(http.MethodDelete, path, opts...)
+}
+
+// Head issues a HEAD request against path.
+func Head(path string, opts ...Option) (*http.Response, error) {
+	return do(http.MethodHead, path, opts...)
+}
+
+// Hijack issues a POST request against path and takes over the underlying
+// connection, for streaming attach/exec I/O.
+func Hijack(path string, opts ...Option) (*http.Response, net.Conn, *bufio.Reader, error) {
+	req, err := http.NewRequest(http.MethodPost, "http://d"+path, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	conn, err := net.Dial("unix", sockPath())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	clientconn := httputil.NewClientConn(conn, nil)
+	resp, err := clientconn.Do(req)
+	if err != nil && err != httputil.ErrPersistEOF {
+		conn.Close()
+		return nil, nil, nil, err
+	}
+
+	rwc, br := clientconn.Hijack()
+	return resp, rwc, br, nil
+}
+
+// DecodeBody JSON-decodes body into out.
+func DecodeBody(out interface{}, body io.Reader) error {
+	return json.NewDecoder(body).Decode(out)
+}
@@ -2,11 +2,15 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"errors"
+	"io"
 	"net"
 	"net/http"
-	"net/url"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/errdefs"
+	"github.com/alibaba/pouch/test/apiclient"
 	"github.com/alibaba/pouch/test/request"
 
 	"github.com/go-check/check"
@@ -18,6 +22,10 @@ const (
 	helloworldImage = "registry.hub.docker.com/library/hello-world"
 )
 
+// apiClient is the typed client used by the helpers below to talk to the
+// daemon under test.
+var apiClient = apiclient.NewAPIClient()
+
 // VerifyCondition is used to check the condition value.
 type VerifyCondition func() bool
 
@@ -31,99 +39,82 @@ func SkipIfFalse(c *check.C, conditions ...VerifyCondition) {
 }
 
 // CreateBusyboxContainerOk creates a busybox container and asserts success.
-func CreateBusyboxContainerOk(c *check.C, cname string, cmd ...string) {
+func CreateBusyboxContainerOk(c *check.C, cname string, cmd ...string) *types.ContainerCreateResp {
 	// If not specified, CMD executed in container is "top".
 	if len(cmd) == 0 {
 		cmd = []string{"top"}
 	}
 
-	resp, err := CreateBusyboxContainer(c, cname, cmd...)
+	got, err := CreateBusyboxContainer(c, cname, cmd...)
 	c.Assert(err, check.IsNil)
-	CheckRespStatus(c, resp, 201)
+	return got
 }
 
 // CreateBusyboxContainer creates a basic container using busybox image.
-func CreateBusyboxContainer(c *check.C, cname string, cmd ...string) (*http.Response, error) {
-	q := url.Values{}
-	q.Add("name", cname)
-
-	obj := map[string]interface{}{
-		"Image":      busyboxImage,
-		"Cmd":        cmd,
-		"HostConfig": map[string]interface{}{},
+func CreateBusyboxContainer(c *check.C, cname string, cmd ...string) (*types.ContainerCreateResp, error) {
+	cfg := &types.ContainerCreateConfig{
+		ContainerConfig: types.ContainerConfig{
+			Image: busyboxImage,
+			Cmd:   cmd,
+		},
+		HostConfig: &types.HostConfig{},
 	}
-
-	path := "/containers/create"
-	query := request.WithQuery(q)
-	body := request.WithJSONBody(obj)
-	return request.Post(path, query, body)
+	return apiClient.ContainerCreate(context.Background(), cname, cfg)
 }
 
 // StartContainerOk starts the container and asserts success.
 func StartContainerOk(c *check.C, cname string) {
-	resp, err := StartContainer(c, cname)
+	err := StartContainer(c, cname)
 	c.Assert(err, check.IsNil)
-
-	CheckRespStatus(c, resp, 204)
 }
 
 // StartContainer starts the container.
-func StartContainer(c *check.C, cname string) (*http.Response, error) {
-	return request.Post("/containers/" + cname + "/start")
+func StartContainer(c *check.C, cname string) error {
+	return apiClient.ContainerStart(context.Background(), cname)
 }
 
 // DelContainerForceOk forcely deletes the container and asserts success.
 func DelContainerForceOk(c *check.C, cname string) {
-	resp, err := DelContainerForce(c, cname)
+	err := DelContainerForce(c, cname)
 	c.Assert(err, check.IsNil)
-
-	CheckRespStatus(c, resp, 204)
 }
 
 // DelContainerForce forcely deletes the container.
-func DelContainerForce(c *check.C, cname string) (*http.Response, error) {
-	q := url.Values{}
-	q.Add("force", "true")
-	return request.Delete("/containers/"+cname, request.WithQuery(q))
+func DelContainerForce(c *check.C, cname string) error {
+	return apiClient.ContainerRemove(context.Background(), cname, true)
 }
 
 // StopContainerOk stops the container and asserts success..
 func StopContainerOk(c *check.C, cname string) {
-	resp, err := StopContainer(c, cname)
+	err := StopContainer(c, cname)
 	c.Assert(err, check.IsNil)
-
-	CheckRespStatus(c, resp, 204)
 }
 
 // StopContainer stops the container.
-func StopContainer(c *check.C, cname string) (*http.Response, error) {
-	return request.Post("/containers/" + cname + "/stop")
+func StopContainer(c *check.C, cname string) error {
+	return apiClient.ContainerStop(context.Background(), cname)
 }
 
 // PauseContainerOk pauses the container and asserts success..
 func PauseContainerOk(c *check.C, cname string) {
-	resp, err := PauseContainer(c, cname)
+	err := PauseContainer(c, cname)
 	c.Assert(err, check.IsNil)
-
-	CheckRespStatus(c, resp, 204)
 }
 
 // PauseContainer pauses the container.
-func PauseContainer(c *check.C, cname string) (*http.Response, error) {
-	return request.Post("/containers/" + cname + "/pause")
+func PauseContainer(c *check.C, cname string) error {
+	return apiClient.ContainerPause(context.Background(), cname)
 }
 
 // UnpauseContainerOk unpauses the container and asserts success..
 func UnpauseContainerOk(c *check.C, cname string) {
-	resp, err := UnpauseContainer(c, cname)
+	err := UnpauseContainer(c, cname)
 	c.Assert(err, check.IsNil)
-
-	CheckRespStatus(c, resp, 204)
 }
 
 // UnpauseContainer unpauses the container.
-func UnpauseContainer(c *check.C, cname string) (*http.Response, error) {
-	return request.Post("/containers/" + cname + "/unpause")
+func UnpauseContainer(c *check.C, cname string) error {
+	return apiClient.ContainerUnpause(context.Background(), cname)
 }
 
 // CheckRespStatus checks the http.Response.Status is equal to status.
@@ -135,6 +126,24 @@ func CheckRespStatus(c *check.C, resp *http.Response, status int) {
 	}
 }
 
+// CheckRespError asserts that resp represents a failure that classify
+// reports true for, e.g. CheckRespError(c, resp, errdefs.IsNotFound).
+// Prefer this over CheckRespStatus(c, resp, 404)-style assertions, since it
+// distinguishes "not found" from "conflict" from "validation error" instead
+// of relying on a specific status code.
+func CheckRespError(c *check.C, resp *http.Response, classify func(error) bool) {
+	defer resp.Body.Close()
+
+	got := types.Error{}
+	// HEAD responses carry no body, so an empty body is accepted here.
+	if err := request.DecodeBody(&got, resp.Body); err != io.EOF {
+		c.Assert(err, check.IsNil)
+	}
+
+	classified := errdefs.FromStatusCode(errors.New(got.Message), resp.StatusCode)
+	c.Assert(classify(classified), check.Equals, true, check.Commentf("status %d: %s", resp.StatusCode, got.Message))
+}
+
 // IsContainerCreated returns true is container's state is created.
 func IsContainerCreated(c *check.C, cname string) (bool, error) {
 	return isContainerStateEqual(c, cname, "created")
@@ -146,13 +155,7 @@ func IsContainerRunning(c *check.C, cname string) (bool, error) {
 }
 
 func isContainerStateEqual(c *check.C, cname string, status string) (bool, error) {
-	resp, err := request.Get("/containers/" + cname + "/json")
-	c.Assert(err, check.IsNil)
-	c.Assert(resp.StatusCode, check.Equals, 200)
-
-	defer resp.Body.Close()
-	got := types.ContainerJSON{}
-	err = request.DecodeBody(&got, resp.Body)
+	got, err := apiClient.ContainerInspect(context.Background(), cname)
 	c.Assert(err, check.IsNil)
 
 	if got.State == nil {
@@ -162,40 +165,47 @@ func isContainerStateEqual(c *check.C, cname string, status string) (bool, error
 	return string(got.State.Status) == status, nil
 }
 
+// TagImageOk adds newTag as a new reference to the image referenced by ref
+// and asserts success.
+func TagImageOk(c *check.C, ref, newTag string) {
+	err := apiClient.ImageTag(context.Background(), ref, newTag)
+	c.Assert(err, check.IsNil)
+}
+
+// DelImageForceOk forcibly removes the image referenced by ref and asserts
+// success.
+func DelImageForceOk(c *check.C, ref string) {
+	_, err := apiClient.ImageRemove(context.Background(), ref, true, false)
+	c.Assert(err, check.IsNil)
+}
+
 // DelNetworkOk deletes the network and asserts success.
 func DelNetworkOk(c *check.C, cname string) {
-	resp, err := DelNetwork(c, cname)
+	err := DelNetwork(c, cname)
 	c.Assert(err, check.IsNil)
-
-	CheckRespStatus(c, resp, 204)
 }
 
 // DelNetwork  deletes the network.
-func DelNetwork(c *check.C, cname string) (*http.Response, error) {
-	return request.Delete("/networks/" + cname)
+func DelNetwork(c *check.C, cname string) error {
+	return apiClient.NetworkRemove(context.Background(), cname)
 }
 
 // CreateExecEchoOk exec process's environment with "echo" CMD.
 func CreateExecEchoOk(c *check.C, cname string) string {
 	// NOTICE:
-	// All files in the obj is needed, or start a new process may hang.
-	obj := map[string]interface{}{
-		"Cmd":          []string{"echo", "test"},
-		"Detach":       true,
-		"AttachStderr": true,
-		"AttachStdout": true,
-		"AttachStdin":  true,
-		"Privileged":   false,
-		"User":         "",
+	// All fields in the cfg are needed, or starting a new process may hang.
+	cfg := &types.ExecCreateConfig{
+		Cmd:          []string{"echo", "test"},
+		Detach:       true,
+		AttachStderr: true,
+		AttachStdout: true,
+		AttachStdin:  true,
+		Privileged:   false,
+		User:         "",
 	}
-	body := request.WithJSONBody(obj)
 
-	resp, err := request.Post("/containers/"+cname+"/exec", body)
+	got, err := apiClient.ContainerExecCreate(context.Background(), cname, cfg)
 	c.Assert(err, check.IsNil)
-	CheckRespStatus(c, resp, 201)
-
-	var got types.ExecCreateResp
-	request.DecodeBody(&got, resp.Body)
 	return got.ID
 }
 
@@ -211,13 +221,34 @@ func StartContainerExecOk(c *check.C, execid string, tty bool, detach bool) {
 
 // StartContainerExec starts executing a process in the container.
 func StartContainerExec(c *check.C, execid string, tty bool, detach bool) (*http.Response, net.Conn, *bufio.Reader, error) {
-
-	obj := map[string]interface{}{
-		"Detach": detach,
-		"Tty":    tty,
+	cfg := &types.ExecStartConfig{
+		Detach: detach,
+		Tty:    tty,
 	}
-	body := request.WithJSONBody(obj)
+	return apiClient.ContainerExecStart(context.Background(), execid, cfg)
+}
+
+// GetContainerArchiveOk gets path from cname's filesystem as a tar stream
+// and asserts success. The caller must Close the returned reader once done
+// reading it, e.g. by wrapping it in tar.NewReader and closing the reader
+// separately.
+func GetContainerArchiveOk(c *check.C, cname, path string) (io.ReadCloser, *types.ContainerPathStat) {
+	r, stat, err := apiClient.GetContainerArchive(context.Background(), cname, path)
+	c.Assert(err, check.IsNil)
+	return r, stat
+}
 
-	resp, conn, reader, err := request.Hijack("/exec/"+execid+"/start", body, request.WithHeader("Content-Type", "text/plain"))
-	return resp, conn, reader, err
+// PutContainerArchiveOk extracts tarStream at path in cname's filesystem and
+// asserts success.
+func PutContainerArchiveOk(c *check.C, cname, path string, tarStream io.Reader, opts ...apiclient.PutOpt) {
+	resp, err := apiClient.PutContainerArchive(context.Background(), cname, path, tarStream, opts...)
+	c.Assert(err, check.IsNil)
+	CheckRespStatus(c, resp, 200)
+}
+
+// StatContainerPathOk stats path in cname's filesystem and asserts success.
+func StatContainerPathOk(c *check.C, cname, path string) *types.ContainerPathStat {
+	stat, err := apiClient.StatContainerPath(context.Background(), cname, path)
+	c.Assert(err, check.IsNil)
+	return stat
 }
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/test/poll"
+
+	"github.com/go-check/check"
+)
+
+// WaitContainerStateOk waits for the container's state to reach state and
+// asserts that it does so before the timeout.
+func WaitContainerStateOk(c *check.C, cname, state string, opts ...poll.Option) {
+	err := WaitContainerState(c, cname, state, opts...)
+	c.Assert(err, check.IsNil)
+}
+
+// WaitContainerState polls the container's inspect result on a configurable
+// interval (default 100ms) until State.Status equals state, or returns a
+// descriptive error including the last-observed status and exit code once
+// the timeout (default 30s) elapses.
+func WaitContainerState(c *check.C, cname, state string, opts ...poll.Option) error {
+	var last *types.ContainerState
+	err := poll.Wait(fmt.Sprintf("waiting for container %s to reach state %s", cname, state), func() poll.Result {
+		got, err := inspectContainerState(c, cname)
+		if err != nil {
+			return poll.Error(err)
+		}
+		last = got
+		if last != nil && string(last.Status) == state {
+			return poll.Success()
+		}
+		return poll.Continue()
+	}, opts...)
+	if err != nil {
+		if last == nil {
+			return fmt.Errorf("%v: container %s was never observed", err, cname)
+		}
+		return fmt.Errorf("%v: last observed status %q, exit code %d", err, last.Status, last.ExitCode)
+	}
+	return nil
+}
+
+// WaitContainerExit waits for the container to reach the stopped state and
+// returns its exit code.
+func WaitContainerExit(c *check.C, cname string) (int64, error) {
+	if err := WaitContainerState(c, cname, "stopped"); err != nil {
+		return 0, err
+	}
+
+	got, err := inspectContainerState(c, cname)
+	if err != nil {
+		return 0, err
+	}
+	return got.ExitCode, nil
+}
+
+// inspectContainerState fetches the container's current state via inspect.
+func inspectContainerState(c *check.C, cname string) (*types.ContainerState, error) {
+	got, err := apiClient.ContainerInspect(context.Background(), cname)
+	if err != nil {
+		return nil, err
+	}
+	return got.State, nil
+}